@@ -1,6 +1,7 @@
 package datafactory
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -36,6 +37,15 @@ func resourceDataFactoryDatasetPostgreSQL() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []pluginsdk.StateUpgrade{
+			{
+				Type:    resourceDataFactoryDatasetPostgreSQLV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceDataFactoryDatasetPostgreSQLUpgradeV0ToV1,
+				Version: 0,
+			},
+		},
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -80,6 +90,12 @@ func resourceDataFactoryDatasetPostgreSQL() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"schema": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			"parameters": {
 				Type:     pluginsdk.TypeMap,
 				Optional: true,
@@ -194,8 +210,9 @@ func resourceDataFactoryDatasetPostgreSQLCreateUpdate(d *pluginsdk.ResourceData,
 		}
 	}
 
-	postgresqlDatasetProperties := datafactory.RelationalTableDatasetTypeProperties{
-		TableName: d.Get("table_name").(string),
+	postgresqlDatasetProperties := datafactory.PostgreSqlTableDatasetTypeProperties{
+		Table:  d.Get("table_name").(string),
+		Schema: d.Get("schema").(string),
 	}
 
 	linkedServiceName := d.Get("linked_service_name").(string)
@@ -206,8 +223,8 @@ func resourceDataFactoryDatasetPostgreSQLCreateUpdate(d *pluginsdk.ResourceData,
 	}
 
 	description := d.Get("description").(string)
-	postgresqlTableset := datafactory.RelationalTableDataset{
-		RelationalTableDatasetTypeProperties: &postgresqlDatasetProperties,
+	postgresqlTableset := datafactory.PostgreSqlTableDataset{
+		PostgreSqlTableDatasetTypeProperties: &postgresqlDatasetProperties,
 		LinkedServiceName:                    linkedService,
 		Description:                          &description,
 	}
@@ -236,7 +253,7 @@ func resourceDataFactoryDatasetPostgreSQLCreateUpdate(d *pluginsdk.ResourceData,
 		postgresqlTableset.Structure = expandDataFactoryDatasetStructure(v.([]interface{}))
 	}
 
-	datasetType := string(datafactory.TypeBasicDatasetTypePostgreSQLTable)
+	datasetType := string(datafactory.TypeBasicDatasetTypePostgreSQLV2Table)
 	dataset := datafactory.DatasetResource{
 		Properties: &postgresqlTableset,
 		Type:       &datasetType,
@@ -279,51 +296,98 @@ func resourceDataFactoryDatasetPostgreSQLRead(d *pluginsdk.ResourceData, meta in
 	d.Set("data_factory_name", id.FactoryName)
 	d.Set("data_factory_id", dataFactoryId.ID())
 
-	postgresqlTable, ok := resp.Properties.AsRelationalTableDataset()
-	if !ok {
-		return fmt.Errorf("classifying Data Factory Dataset PostgreSQL %s: Expected: %q Received: %q", *id, datafactory.TypeBasicDatasetTypePostgreSQLTable, *resp.Type)
-	}
+	// Datasets created by a provider version prior to this one are still classified by
+	// the API as the generic `RelationalTableDataset` - they're only upgraded to the
+	// typed `PostgreSqlTableDataset` on the next apply, so both shapes need to be
+	// handled here until then.
+	if postgresqlTable, ok := resp.Properties.AsPostgreSqlTableDataset(); ok {
+		d.Set("additional_properties", postgresqlTable.AdditionalProperties)
 
-	d.Set("additional_properties", postgresqlTable.AdditionalProperties)
+		if postgresqlTable.Description != nil {
+			d.Set("description", postgresqlTable.Description)
+		}
 
-	if postgresqlTable.Description != nil {
-		d.Set("description", postgresqlTable.Description)
-	}
+		parameters := flattenDataFactoryParameters(postgresqlTable.Parameters)
+		if err := d.Set("parameters", parameters); err != nil {
+			return fmt.Errorf("setting `parameters`: %+v", err)
+		}
 
-	parameters := flattenDataFactoryParameters(postgresqlTable.Parameters)
-	if err := d.Set("parameters", parameters); err != nil {
-		return fmt.Errorf("setting `parameters`: %+v", err)
-	}
+		annotations := flattenDataFactoryAnnotations(postgresqlTable.Annotations)
+		if err := d.Set("annotations", annotations); err != nil {
+			return fmt.Errorf("setting `annotations`: %+v", err)
+		}
 
-	annotations := flattenDataFactoryAnnotations(postgresqlTable.Annotations)
-	if err := d.Set("annotations", annotations); err != nil {
-		return fmt.Errorf("setting `annotations`: %+v", err)
-	}
+		if linkedService := postgresqlTable.LinkedServiceName; linkedService != nil {
+			if linkedService.ReferenceName != nil {
+				d.Set("linked_service_name", linkedService.ReferenceName)
+			}
+		}
+
+		if properties := postgresqlTable.PostgreSqlTableDatasetTypeProperties; properties != nil {
+			if val, ok := properties.Table.(string); ok {
+				d.Set("table_name", val)
+			} else {
+				log.Printf("[DEBUG] Skipping `table_name` since it's not a string")
+			}
 
-	if linkedService := postgresqlTable.LinkedServiceName; linkedService != nil {
-		if linkedService.ReferenceName != nil {
-			d.Set("linked_service_name", linkedService.ReferenceName)
+			if val, ok := properties.Schema.(string); ok {
+				d.Set("schema", val)
+			} else {
+				log.Printf("[DEBUG] Skipping `schema` since it's not a string")
+			}
 		}
-	}
 
-	if properties := postgresqlTable.RelationalTableDatasetTypeProperties; properties != nil {
-		val, ok := properties.TableName.(string)
-		if !ok {
-			log.Printf("[DEBUG] Skipping `table_name` since it's not a string")
-		} else {
-			d.Set("table_name", val)
+		if folder := postgresqlTable.Folder; folder != nil {
+			if folder.Name != nil {
+				d.Set("folder", folder.Name)
+			}
 		}
-	}
 
-	if folder := postgresqlTable.Folder; folder != nil {
-		if folder.Name != nil {
-			d.Set("folder", folder.Name)
+		if err := d.Set("schema_column", flattenDataFactoryStructureColumns(postgresqlTable.Structure)); err != nil {
+			return fmt.Errorf("setting `schema_column`: %+v", err)
 		}
-	}
+	} else if legacyTable, ok := resp.Properties.AsRelationalTableDataset(); ok {
+		d.Set("additional_properties", legacyTable.AdditionalProperties)
 
-	structureColumns := flattenDataFactoryStructureColumns(postgresqlTable.Structure)
-	if err := d.Set("schema_column", structureColumns); err != nil {
-		return fmt.Errorf("setting `schema_column`: %+v", err)
+		if legacyTable.Description != nil {
+			d.Set("description", legacyTable.Description)
+		}
+
+		parameters := flattenDataFactoryParameters(legacyTable.Parameters)
+		if err := d.Set("parameters", parameters); err != nil {
+			return fmt.Errorf("setting `parameters`: %+v", err)
+		}
+
+		annotations := flattenDataFactoryAnnotations(legacyTable.Annotations)
+		if err := d.Set("annotations", annotations); err != nil {
+			return fmt.Errorf("setting `annotations`: %+v", err)
+		}
+
+		if linkedService := legacyTable.LinkedServiceName; linkedService != nil {
+			if linkedService.ReferenceName != nil {
+				d.Set("linked_service_name", linkedService.ReferenceName)
+			}
+		}
+
+		if properties := legacyTable.RelationalTableDatasetTypeProperties; properties != nil {
+			if val, ok := properties.TableName.(string); ok {
+				d.Set("table_name", val)
+			} else {
+				log.Printf("[DEBUG] Skipping `table_name` since it's not a string")
+			}
+		}
+
+		if folder := legacyTable.Folder; folder != nil {
+			if folder.Name != nil {
+				d.Set("folder", folder.Name)
+			}
+		}
+
+		if err := d.Set("schema_column", flattenDataFactoryStructureColumns(legacyTable.Structure)); err != nil {
+			return fmt.Errorf("setting `schema_column`: %+v", err)
+		}
+	} else {
+		return fmt.Errorf("classifying Data Factory Dataset PostgreSQL %s: Expected: %q or %q Received: %q", *id, datafactory.TypeBasicDatasetTypePostgreSQLTable, datafactory.TypeBasicDatasetTypeRelationalTable, *resp.Type)
 	}
 
 	return nil
@@ -348,3 +412,107 @@ func resourceDataFactoryDatasetPostgreSQLDelete(d *pluginsdk.ResourceData, meta
 
 	return nil
 }
+
+// resourceDataFactoryDatasetPostgreSQLV0 is the Schema as it existed before this
+// dataset switched from the generic `RelationalTableDataset` to the typed
+// `PostgreSqlTableDataset`, which introduced the `schema` property.
+func resourceDataFactoryDatasetPostgreSQLV0() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"data_factory_name": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"data_factory_id": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"resource_group_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"linked_service_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"table_name": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"parameters": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"annotations": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"folder": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"additional_properties": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"schema_column": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDataFactoryDatasetPostgreSQLUpgradeV0ToV1(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	if _, ok := rawState["schema"]; !ok {
+		rawState["schema"] = ""
+	}
+
+	return rawState, nil
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2022-12-01/loadtests"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtest/dataplane/testruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtest/dataplane/tests"
+)
+
+// dataPlaneResourceId is the AAD audience the Azure Load Testing data-plane API
+// (test definitions, test runs, file uploads) is secured against. It is distinct
+// from the ARM audience used by LoadTestsClient.
+const dataPlaneResourceId = "https://cnt-prod.loadtesting.azure.com"
+
+type Client struct {
+	LoadTestsClient *loadtests.LoadTestsClient
+
+	dataPlaneAuthorizer auth.Authorizer
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	loadTestsClient, err := loadtests.NewLoadTestsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building LoadTests client: %+v", err)
+	}
+	o.Configure(loadTestsClient.Client, o.Authorizers.ResourceManager)
+
+	dataPlaneAuthorizer, err := o.Authorizers.AuthorizerFunc(environments.Api{
+		ResourceIdentifier: dataPlaneResourceId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("building Load Testing data-plane authorizer: %+v", err)
+	}
+
+	return &Client{
+		LoadTestsClient:     loadTestsClient,
+		dataPlaneAuthorizer: dataPlaneAuthorizer,
+	}, nil
+}
+
+// NewTestsClient builds a data-plane client scoped to the Load Test resource whose
+// data-plane endpoint is dataPlaneURI (the `data_plane_uri` attribute of the
+// `azurerm_load_test` resource/data source).
+func (c *Client) NewTestsClient(dataPlaneURI string) *tests.Client {
+	testsClient := tests.NewClientWithBaseURI(dataPlaneURI)
+	testsClient.Client.Authorizer = c.dataPlaneAuthorizer
+	return testsClient
+}
+
+// NewTestRunsClient builds a data-plane client, scoped the same way as NewTestsClient,
+// for triggering and observing test runs.
+func (c *Client) NewTestRunsClient(dataPlaneURI string) *testruns.Client {
+	testRunsClient := testruns.NewClientWithBaseURI(dataPlaneURI)
+	testRunsClient.Client.Authorizer = c.dataPlaneAuthorizer
+	return testRunsClient
+}
@@ -0,0 +1,152 @@
+package testruns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+const apiVersion = "2022-11-01"
+
+// Client talks to the Azure Load Testing data-plane test-run endpoints, e.g.
+// `<dataPlaneURI>/test-runs`.
+type Client struct {
+	Client *client.Client
+}
+
+func NewClientWithBaseURI(dataPlaneURI string) *Client {
+	baseClient := client.NewClient(fmt.Sprintf("https://%s", dataPlaneURI), "loadtest", apiVersion)
+	return &Client{
+		Client: baseClient,
+	}
+}
+
+func (c Client) CreateOrUpdateTestRun(ctx context.Context, testRunId string, parameters TestRun) (result TestRun, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/merge-patch+json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+			http.StatusCreated,
+		},
+		OptionsObject: apiVersionOptions{},
+		Path:          fmt.Sprintf("/test-runs/%s", testRunId),
+	}
+
+	req, err := c.Client.NewRequest(ctx, http.MethodPatch, opts)
+	if err != nil {
+		return result, fmt.Errorf("building request: %+v", err)
+	}
+
+	if err := req.Marshal(parameters); err != nil {
+		return result, fmt.Errorf("marshalling request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return result, fmt.Errorf("executing request: %+v", err)
+	}
+
+	if err := resp.Unmarshal(&result); err != nil {
+		return result, fmt.Errorf("unmarshalling response: %+v", err)
+	}
+
+	return result, nil
+}
+
+// Get returns the TestRun and the raw HTTP response, so that callers can distinguish
+// "doesn't exist" (a 404) from a transient/auth error via response.WasNotFound.
+func (c Client) Get(ctx context.Context, testRunId string) (result TestRun, httpResponse *http.Response, err error) {
+	opts := client.RequestOptions{
+		ExpectedStatusCodes: []int{http.StatusOK, http.StatusNotFound},
+		OptionsObject:       apiVersionOptions{},
+		Path:                fmt.Sprintf("/test-runs/%s", testRunId),
+	}
+
+	req, err := c.Client.NewRequest(ctx, http.MethodGet, opts)
+	if err != nil {
+		return result, nil, fmt.Errorf("building request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp != nil {
+		httpResponse = resp.HttpResponse
+	}
+	if err != nil {
+		return result, httpResponse, fmt.Errorf("executing request: %+v", err)
+	}
+
+	if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+		return result, httpResponse, nil
+	}
+
+	if err := resp.Unmarshal(&result); err != nil {
+		return result, httpResponse, fmt.Errorf("unmarshalling response: %+v", err)
+	}
+
+	return result, httpResponse, nil
+}
+
+func (c Client) Stop(ctx context.Context, testRunId string) (result TestRun, err error) {
+	opts := client.RequestOptions{
+		ExpectedStatusCodes: []int{http.StatusOK},
+		OptionsObject:       apiVersionOptions{},
+		Path:                fmt.Sprintf("/test-runs/%s:stop", testRunId),
+	}
+
+	req, err := c.Client.NewRequest(ctx, http.MethodPost, opts)
+	if err != nil {
+		return result, fmt.Errorf("building request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return result, fmt.Errorf("executing request: %+v", err)
+	}
+
+	if err := resp.Unmarshal(&result); err != nil {
+		return result, fmt.Errorf("unmarshalling response: %+v", err)
+	}
+
+	return result, nil
+}
+
+// Delete returns the raw HTTP response, so that callers can treat a 404 (the test run
+// was already removed data-plane-side) as a successful, idempotent delete rather than
+// an error.
+func (c Client) Delete(ctx context.Context, testRunId string) (httpResponse *http.Response, err error) {
+	opts := client.RequestOptions{
+		ExpectedStatusCodes: []int{http.StatusOK, http.StatusNoContent, http.StatusNotFound},
+		OptionsObject:       apiVersionOptions{},
+		Path:                fmt.Sprintf("/test-runs/%s", testRunId),
+	}
+
+	req, err := c.Client.NewRequest(ctx, http.MethodDelete, opts)
+	if err != nil {
+		return nil, fmt.Errorf("building request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp != nil {
+		httpResponse = resp.HttpResponse
+	}
+	if err != nil {
+		return httpResponse, fmt.Errorf("executing request: %+v", err)
+	}
+
+	return httpResponse, nil
+}
+
+type apiVersionOptions struct{}
+
+func (o apiVersionOptions) ToHeaders() *client.Headers { return &client.Headers{} }
+
+func (o apiVersionOptions) ToOData() *odata.Query { return &odata.Query{} }
+
+func (o apiVersionOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("api-version", apiVersion)
+	return out
+}
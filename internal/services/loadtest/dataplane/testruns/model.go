@@ -0,0 +1,13 @@
+package testruns
+
+// TestRun is the data-plane representation of an on-demand or scheduled execution
+// of a Load Test, as returned by `GET <dataPlaneURI>/test-runs/{testRunId}`.
+type TestRun struct {
+	TestRunId        string `json:"testRunId,omitempty"`
+	TestId           string `json:"testId,omitempty"`
+	DisplayName      string `json:"displayName,omitempty"`
+	Description      string `json:"description,omitempty"`
+	Status           string `json:"status,omitempty"`
+	PortalUrl        string `json:"portalUrl,omitempty"`
+	ExecutedDateTime string `json:"executedDateTime,omitempty"`
+}
@@ -0,0 +1,163 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+const apiVersion = "2022-11-01"
+
+// Client talks to the Azure Load Testing data-plane, e.g. `<dataPlaneURI>/tests`.
+//
+// Unlike the ARM `loadtests.LoadTestsClient` this is scoped to a single Load Test
+// resource's data-plane URI and is authorized against the
+// `https://cnt-prod.loadtesting.azure.com` audience rather than ARM.
+type Client struct {
+	Client *client.Client
+}
+
+func NewClientWithBaseURI(dataPlaneURI string) *Client {
+	baseClient := client.NewClient(fmt.Sprintf("https://%s", dataPlaneURI), "loadtest", apiVersion)
+	return &Client{
+		Client: baseClient,
+	}
+}
+
+func (c Client) CreateOrUpdateTest(ctx context.Context, testId string, parameters Test) (result Test, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/merge-patch+json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+			http.StatusCreated,
+		},
+		OptionsObject: apiVersionOptions{},
+		Path:          fmt.Sprintf("/tests/%s", testId),
+	}
+
+	req, err := c.Client.NewRequest(ctx, http.MethodPatch, opts)
+	if err != nil {
+		return result, fmt.Errorf("building request: %+v", err)
+	}
+
+	if err := req.Marshal(parameters); err != nil {
+		return result, fmt.Errorf("marshalling request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return result, fmt.Errorf("executing request: %+v", err)
+	}
+
+	if err := resp.Unmarshal(&result); err != nil {
+		return result, fmt.Errorf("unmarshalling response: %+v", err)
+	}
+
+	return result, nil
+}
+
+// Get returns the Test and the raw HTTP response, so that callers can distinguish
+// "doesn't exist" (a 404) from a transient/auth error via response.WasNotFound.
+func (c Client) Get(ctx context.Context, testId string) (result Test, httpResponse *http.Response, err error) {
+	opts := client.RequestOptions{
+		ExpectedStatusCodes: []int{http.StatusOK, http.StatusNotFound},
+		OptionsObject:       apiVersionOptions{},
+		Path:                fmt.Sprintf("/tests/%s", testId),
+	}
+
+	req, err := c.Client.NewRequest(ctx, http.MethodGet, opts)
+	if err != nil {
+		return result, nil, fmt.Errorf("building request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp != nil {
+		httpResponse = resp.HttpResponse
+	}
+	if err != nil {
+		return result, httpResponse, fmt.Errorf("executing request: %+v", err)
+	}
+
+	if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+		return result, httpResponse, nil
+	}
+
+	if err := resp.Unmarshal(&result); err != nil {
+		return result, httpResponse, fmt.Errorf("unmarshalling response: %+v", err)
+	}
+
+	return result, httpResponse, nil
+}
+
+// Delete returns the raw HTTP response, so that callers can treat a 404 (the test was
+// already removed data-plane-side) as a successful, idempotent delete rather than an
+// error.
+func (c Client) Delete(ctx context.Context, testId string) (httpResponse *http.Response, err error) {
+	opts := client.RequestOptions{
+		ExpectedStatusCodes: []int{http.StatusOK, http.StatusNoContent, http.StatusNotFound},
+		OptionsObject:       apiVersionOptions{},
+		Path:                fmt.Sprintf("/tests/%s", testId),
+	}
+
+	req, err := c.Client.NewRequest(ctx, http.MethodDelete, opts)
+	if err != nil {
+		return nil, fmt.Errorf("building request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp != nil {
+		httpResponse = resp.HttpResponse
+	}
+	if err != nil {
+		return httpResponse, fmt.Errorf("executing request: %+v", err)
+	}
+
+	return httpResponse, nil
+}
+
+// UploadTestFile uploads a JMX script (or other supporting file) to the test and
+// triggers the service-side validation pass.
+func (c Client) UploadTestFile(ctx context.Context, testId string, fileName string, contents []byte) (result FileInfo, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/octet-stream",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+			http.StatusCreated,
+		},
+		OptionsObject: apiVersionOptions{},
+		Path:          fmt.Sprintf("/tests/%s/files/%s", testId, fileName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, http.MethodPut, opts)
+	if err != nil {
+		return result, fmt.Errorf("building request: %+v", err)
+	}
+
+	req.Body = contents
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return result, fmt.Errorf("executing request: %+v", err)
+	}
+
+	if err := resp.Unmarshal(&result); err != nil {
+		return result, fmt.Errorf("unmarshalling response: %+v", err)
+	}
+
+	return result, nil
+}
+
+type apiVersionOptions struct{}
+
+func (o apiVersionOptions) ToHeaders() *client.Headers { return &client.Headers{} }
+
+func (o apiVersionOptions) ToOData() *odata.Query { return &odata.Query{} }
+
+func (o apiVersionOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("api-version", apiVersion)
+	return out
+}
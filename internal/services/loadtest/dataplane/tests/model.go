@@ -0,0 +1,57 @@
+package tests
+
+// Test is the data-plane representation of a Load Test definition, as returned by
+// `GET <dataPlaneURI>/tests/{testId}`.
+//
+// CreateOrUpdateTest sends this as a JSON merge-patch (RFC 7396): a map entry with a
+// nil value is sent as `null`, which explicitly clears that entry server-side, while
+// an omitted entry leaves the existing value untouched. EnvironmentVariables and
+// Secrets are therefore `map[string]*T` rather than `map[string]T` so that removed
+// entries can be represented.
+type Test struct {
+	TestId                      string                 `json:"testId,omitempty"`
+	DisplayName                 string                 `json:"displayName,omitempty"`
+	Description                 string                 `json:"description,omitempty"`
+	LoadTestConfiguration       *LoadTestConfiguration `json:"loadTestConfiguration,omitempty"`
+	PassFailCriteria            *PassFailCriteria      `json:"passFailCriteria,omitempty"`
+	EnvironmentVariables        map[string]*string     `json:"environmentVariables,omitempty"`
+	Secrets                     map[string]*Secret     `json:"secrets,omitempty"`
+	KeyvaultReferenceIdentityId string                 `json:"keyvaultReferenceIdentityId,omitempty"`
+	InputArtifacts              *TestInputArtifacts    `json:"inputArtifacts,omitempty"`
+}
+
+type LoadTestConfiguration struct {
+	EngineInstances int64 `json:"engineInstances"`
+	SplitAllCSVs    bool  `json:"splitAllCSVs"`
+}
+
+type PassFailCriteria struct {
+	// a nil value for a given key explicitly clears that metric - PassFailCriteria
+	// is sent via a JSON merge-patch (RFC 7396), under which an omitted key leaves
+	// the existing value untouched rather than removing it.
+	PassFailMetrics map[string]*PassFailMetric `json:"passFailMetrics,omitempty"`
+}
+
+type PassFailMetric struct {
+	ClientMetric string  `json:"clientMetric,omitempty"`
+	Aggregate    string  `json:"aggregate,omitempty"`
+	Condition    string  `json:"condition,omitempty"`
+	Value        float64 `json:"value,omitempty"`
+	RequestName  string  `json:"requestName,omitempty"`
+}
+
+type Secret struct {
+	Value string `json:"value,omitempty"`
+	Type  string `json:"type,omitempty"`
+}
+
+type TestInputArtifacts struct {
+	TestScriptFileInfo *FileInfo `json:"testScriptFileInfo,omitempty"`
+}
+
+type FileInfo struct {
+	FileName         string `json:"fileName,omitempty"`
+	Url              string `json:"url,omitempty"`
+	FileType         string `json:"fileType,omitempty"`
+	ValidationStatus string `json:"validationStatus,omitempty"`
+}
@@ -0,0 +1,23 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2022-12-01/loadtests"
+)
+
+// loadTestDataPlaneURI looks up the parent `azurerm_load_test` and returns its
+// data-plane URI, which `azurerm_load_test_test`/`azurerm_load_test_test_run` need
+// in order to build a data-plane client scoped to that Load Test resource.
+func loadTestDataPlaneURI(ctx context.Context, client *loadtests.LoadTestsClient, id loadtests.LoadTestId) (string, error) {
+	loadTest, err := client.Get(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if loadTest.Model == nil || loadTest.Model.Properties == nil || loadTest.Model.Properties.DataPlaneURI == nil {
+		return "", fmt.Errorf("%s has no data-plane URI yet", id)
+	}
+
+	return *loadTest.Model.Properties.DataPlaneURI, nil
+}
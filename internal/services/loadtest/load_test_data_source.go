@@ -0,0 +1,121 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2022-12-01/loadtests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type LoadTestDataSource struct{}
+
+var _ sdk.DataSource = LoadTestDataSource{}
+
+type LoadTestDataSourceModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	Description       string            `tfschema:"description"`
+	Tags              map[string]string `tfschema:"tags"`
+
+	DataPlaneURI      string `tfschema:"data_plane_uri"`
+	ProvisioningState string `tfschema:"provisioning_state"`
+}
+
+func (r LoadTestDataSource) ModelObject() interface{} {
+	return &LoadTestDataSourceModel{}
+}
+
+func (r LoadTestDataSource) ResourceType() string {
+	return "azurerm_load_test"
+}
+
+func (r LoadTestDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+	}
+}
+
+func (r LoadTestDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"data_plane_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"provisioning_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"tags": commonschema.TagsDataSource(),
+	}
+}
+
+func (r LoadTestDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LoadTest.LoadTestsClient
+
+			var config LoadTestDataSourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := loadtests.NewLoadTestID(metadata.Client.Account.SubscriptionId, config.ResourceGroupName, config.Name)
+
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := LoadTestDataSourceModel{
+				Name:              id.LoadTestName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					if props.Description != nil {
+						state.Description = *props.Description
+					}
+					if props.DataPlaneURI != nil {
+						state.DataPlaneURI = *props.DataPlaneURI
+					}
+					if props.ProvisioningState != nil {
+						state.ProvisioningState = string(*props.ProvisioningState)
+					}
+				}
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
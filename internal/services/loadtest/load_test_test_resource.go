@@ -0,0 +1,580 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2022-12-01/loadtests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtest/dataplane/tests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtest/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtest/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type LoadTestTestResource struct{}
+
+var _ sdk.ResourceWithUpdate = LoadTestTestResource{}
+
+type LoadTestTestPassFailMetricModel struct {
+	ClientMetric string  `tfschema:"client_metric"`
+	Aggregation  string  `tfschema:"aggregation"`
+	Condition    string  `tfschema:"condition"`
+	Threshold    float64 `tfschema:"threshold"`
+	RequestName  string  `tfschema:"request_name"`
+}
+
+type LoadTestTestSecretModel struct {
+	Name             string `tfschema:"name"`
+	KeyVaultSecretId string `tfschema:"key_vault_secret_id"`
+}
+
+type LoadTestTestResourceModel struct {
+	Name                        string                             `tfschema:"name"`
+	LoadTestId                  string                             `tfschema:"load_test_id"`
+	DisplayName                 string                             `tfschema:"display_name"`
+	Description                 string                             `tfschema:"description"`
+	TestScriptFile              string                             `tfschema:"test_script_file"`
+	EngineInstances             int64                              `tfschema:"engine_instances"`
+	SplitCSVEnabled             bool                               `tfschema:"split_csv_enabled"`
+	EnvironmentVariables        map[string]string                  `tfschema:"environment_variables"`
+	Secret                      []LoadTestTestSecretModel          `tfschema:"secret"`
+	KeyVaultReferenceIdentityId string                             `tfschema:"keyvault_reference_identity_id"`
+	PassFailCriteria            []LoadTestTestPassFailMetricModel  `tfschema:"pass_fail_criteria"`
+}
+
+func (r LoadTestTestResource) ModelObject() interface{} {
+	return &LoadTestTestResourceModel{}
+}
+
+func (r LoadTestTestResource) ResourceType() string {
+	return "azurerm_load_test_test"
+}
+
+func (r LoadTestTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.TestID
+}
+
+func (r LoadTestTestResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"load_test_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: loadtests.ValidateLoadTestID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"test_script_file": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"engine_instances": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"split_csv_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"environment_variables": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"secret": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"key_vault_secret_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"keyvault_reference_identity_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"pass_fail_criteria": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"client_metric": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"response_time_ms",
+							"latency",
+							"error",
+							"requests_per_sec",
+							"requests",
+						}, false),
+					},
+
+					"aggregation": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"Avg",
+							"P50",
+							"P90",
+							"P95",
+							"P99",
+							"Min",
+							"Max",
+							"Count",
+						}, false),
+					},
+
+					"condition": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							">",
+							"<",
+						}, false),
+					},
+
+					"threshold": {
+						Type:     pluginsdk.TypeFloat,
+						Required: true,
+					},
+
+					"request_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r LoadTestTestResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r LoadTestTestResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model LoadTestTestResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			loadTestId, err := loadtests.ParseLoadTestID(model.LoadTestId)
+			if err != nil {
+				return err
+			}
+
+			dataPlaneURI, err := loadTestDataPlaneURI(ctx, metadata.Client.LoadTest.LoadTestsClient, *loadTestId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewTestID(loadTestId.SubscriptionId, loadTestId.ResourceGroupName, loadTestId.LoadTestName, model.Name)
+
+			testsClient := metadata.Client.LoadTest.NewTestsClient(dataPlaneURI)
+
+			_, existingHttpResponse, err := testsClient.Get(ctx, id.TestId)
+			if err != nil && !response.WasNotFound(existingHttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existingHttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := testsClient.CreateOrUpdateTest(ctx, id.TestId, expandLoadTestTest(metadata, model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			contents, err := os.ReadFile(model.TestScriptFile)
+			if err != nil {
+				return fmt.Errorf("reading `test_script_file` %q: %+v", model.TestScriptFile, err)
+			}
+
+			if _, err := testsClient.UploadTestFile(ctx, id.TestId, "script.jmx", contents); err != nil {
+				return fmt.Errorf("uploading `test_script_file` for %s: %+v", id, err)
+			}
+
+			if err := waitForLoadTestTestScriptValidation(ctx, testsClient, id.TestId); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LoadTestTestResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.TestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// preserve the existing `test_script_file` and `secret` values - neither is
+			// echoed back by the data-plane API (the former is a local path that was
+			// uploaded from, the latter is a write-only Key Vault reference)
+			var existing LoadTestTestResourceModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			loadTestId := loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroup, id.LoadTestName)
+
+			dataPlaneURI, err := loadTestDataPlaneURI(ctx, metadata.Client.LoadTest.LoadTestsClient, loadTestId)
+			if err != nil {
+				return err
+			}
+
+			testsClient := metadata.Client.LoadTest.NewTestsClient(dataPlaneURI)
+
+			test, httpResponse, err := testsClient.Get(ctx, id.TestId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if response.WasNotFound(httpResponse) {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := LoadTestTestResourceModel{
+				Name:                        id.TestId,
+				LoadTestId:                  loadTestId.ID(),
+				DisplayName:                 test.DisplayName,
+				Description:                 test.Description,
+				KeyVaultReferenceIdentityId: test.KeyvaultReferenceIdentityId,
+				EnvironmentVariables:        flattenLoadTestTestEnvironmentVariables(test.EnvironmentVariables),
+				TestScriptFile:              existing.TestScriptFile,
+				Secret:                      existing.Secret,
+			}
+
+			if config := test.LoadTestConfiguration; config != nil {
+				state.EngineInstances = config.EngineInstances
+				state.SplitCSVEnabled = config.SplitAllCSVs
+			}
+
+			state.PassFailCriteria = flattenLoadTestTestPassFailCriteria(test.PassFailCriteria)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LoadTestTestResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.TestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LoadTestTestResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			loadTestId := loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroup, id.LoadTestName)
+
+			dataPlaneURI, err := loadTestDataPlaneURI(ctx, metadata.Client.LoadTest.LoadTestsClient, loadTestId)
+			if err != nil {
+				return err
+			}
+
+			testsClient := metadata.Client.LoadTest.NewTestsClient(dataPlaneURI)
+
+			if _, err := testsClient.CreateOrUpdateTest(ctx, id.TestId, expandLoadTestTest(metadata, model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			if metadata.ResourceData.HasChange("test_script_file") {
+				contents, err := os.ReadFile(model.TestScriptFile)
+				if err != nil {
+					return fmt.Errorf("reading `test_script_file` %q: %+v", model.TestScriptFile, err)
+				}
+
+				if _, err := testsClient.UploadTestFile(ctx, id.TestId, "script.jmx", contents); err != nil {
+					return fmt.Errorf("uploading `test_script_file` for %s: %+v", *id, err)
+				}
+
+				if err := waitForLoadTestTestScriptValidation(ctx, testsClient, id.TestId); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LoadTestTestResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.TestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			loadTestId := loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroup, id.LoadTestName)
+
+			dataPlaneURI, err := loadTestDataPlaneURI(ctx, metadata.Client.LoadTest.LoadTestsClient, loadTestId)
+			if err != nil {
+				return err
+			}
+
+			testsClient := metadata.Client.LoadTest.NewTestsClient(dataPlaneURI)
+
+			if httpResponse, err := testsClient.Delete(ctx, id.TestId); err != nil && !response.WasNotFound(httpResponse) {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// waitForLoadTestTestScriptValidation polls until the service finishes validating the
+// JMX script uploaded by UploadTestFile. UploadTestFile's PUT only accepts the file and
+// returns before validation completes, so without this a test run created against this
+// test immediately afterwards (a very normal single-apply chain) can race an
+// in-progress validation.
+func waitForLoadTestTestScriptValidation(ctx context.Context, testsClient *tests.Client, testId string) error {
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"NOT_VALIDATED", "VALIDATION_INITIATED"},
+		Target:  []string{"VALIDATION_SUCCESS", "VALIDATION_FAILURE"},
+		Refresh: func() (interface{}, string, error) {
+			test, _, err := testsClient.Get(ctx, testId)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving Test %q: %+v", testId, err)
+			}
+			if test.InputArtifacts == nil || test.InputArtifacts.TestScriptFileInfo == nil {
+				return test, "NOT_VALIDATED", nil
+			}
+			return test, test.InputArtifacts.TestScriptFileInfo.ValidationStatus, nil
+		},
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline(ctx)),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for `test_script_file` validation for Test %q: %+v", testId, err)
+	}
+
+	return nil
+}
+
+// expandLoadTestTest builds the merge-patch body for CreateOrUpdateTest. Since the
+// data-plane API is a JSON merge-patch (RFC 7396), a map key has to be explicitly sent
+// as `null` to clear it - simply omitting a key that existed in a prior apply would
+// leave its old value in place server-side. metadata is used to diff against the prior
+// state (via ResourceData.GetChange) so that removed `environment_variables`, `secret`,
+// and `pass_fail_criteria` entries are nulled out rather than silently left stale. On
+// Create the prior state is empty, so there are no removed entries and nothing is nulled.
+func expandLoadTestTest(metadata sdk.ResourceMetaData, model LoadTestTestResourceModel) tests.Test {
+	return tests.Test{
+		TestId:      model.Name,
+		DisplayName: model.DisplayName,
+		Description: model.Description,
+		LoadTestConfiguration: &tests.LoadTestConfiguration{
+			EngineInstances: model.EngineInstances,
+			SplitAllCSVs:    model.SplitCSVEnabled,
+		},
+		KeyvaultReferenceIdentityId: model.KeyVaultReferenceIdentityId,
+		EnvironmentVariables:        expandLoadTestTestEnvironmentVariables(metadata, model),
+		Secrets:                     expandLoadTestTestSecrets(metadata, model),
+		PassFailCriteria:            expandLoadTestTestPassFailCriteria(metadata, model),
+	}
+}
+
+func expandLoadTestTestEnvironmentVariables(metadata sdk.ResourceMetaData, model LoadTestTestResourceModel) map[string]*string {
+	output := make(map[string]*string)
+	for k, v := range model.EnvironmentVariables {
+		value := v
+		output[k] = &value
+	}
+
+	if metadata.ResourceData.HasChange("environment_variables") {
+		old, _ := metadata.ResourceData.GetChange("environment_variables")
+		for k := range old.(map[string]interface{}) {
+			if _, ok := model.EnvironmentVariables[k]; !ok {
+				output[k] = nil
+			}
+		}
+	}
+
+	if len(output) == 0 {
+		return nil
+	}
+	return output
+}
+
+func expandLoadTestTestSecrets(metadata sdk.ResourceMetaData, model LoadTestTestResourceModel) map[string]*tests.Secret {
+	output := make(map[string]*tests.Secret)
+	for _, s := range model.Secret {
+		secret := tests.Secret{
+			Value: s.KeyVaultSecretId,
+			Type:  "AKV_SECRET_URI",
+		}
+		output[s.Name] = &secret
+	}
+
+	if metadata.ResourceData.HasChange("secret") {
+		old, _ := metadata.ResourceData.GetChange("secret")
+		for _, raw := range old.(*pluginsdk.Set).List() {
+			name := raw.(map[string]interface{})["name"].(string)
+			if _, ok := output[name]; !ok {
+				output[name] = nil
+			}
+		}
+	}
+
+	if len(output) == 0 {
+		return nil
+	}
+	return output
+}
+
+func expandLoadTestTestPassFailCriteria(metadata sdk.ResourceMetaData, model LoadTestTestResourceModel) *tests.PassFailCriteria {
+	metrics := make(map[string]*tests.PassFailMetric)
+	for i, c := range model.PassFailCriteria {
+		metric := tests.PassFailMetric{
+			ClientMetric: c.ClientMetric,
+			Aggregate:    c.Aggregation,
+			Condition:    c.Condition,
+			Value:        c.Threshold,
+			RequestName:  c.RequestName,
+		}
+		metrics[fmt.Sprintf("metric%d", i+1)] = &metric
+	}
+
+	if metadata.ResourceData.HasChange("pass_fail_criteria") {
+		old, _ := metadata.ResourceData.GetChange("pass_fail_criteria")
+		// the config always re-numbers from metric1, so any index beyond the new
+		// length that existed in the prior apply needs to be explicitly nulled out
+		for i := len(model.PassFailCriteria); i < len(old.([]interface{})); i++ {
+			metrics[fmt.Sprintf("metric%d", i+1)] = nil
+		}
+	}
+
+	if len(metrics) == 0 {
+		return nil
+	}
+	return &tests.PassFailCriteria{PassFailMetrics: metrics}
+}
+
+func flattenLoadTestTestPassFailCriteria(input *tests.PassFailCriteria) []LoadTestTestPassFailMetricModel {
+	output := make([]LoadTestTestPassFailMetricModel, 0)
+	if input == nil {
+		return output
+	}
+
+	// PassFailMetrics is a map keyed by a synthetic `metricN` identifier - Go randomizes
+	// map iteration order, so without sorting by that key this would produce a different
+	// ordering (and therefore a permanent diff against the `pass_fail_criteria` list) on
+	// every Read. Sort numerically rather than lexicographically, since "metric10" would
+	// otherwise sort before "metric2".
+	keys := make([]string, 0, len(input.PassFailMetrics))
+	for key := range input.PassFailMetrics {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return passFailMetricKeyIndex(keys[i]) < passFailMetricKeyIndex(keys[j])
+	})
+
+	for _, key := range keys {
+		metric := input.PassFailMetrics[key]
+		if metric == nil {
+			continue
+		}
+		output = append(output, LoadTestTestPassFailMetricModel{
+			ClientMetric: metric.ClientMetric,
+			Aggregation:  metric.Aggregate,
+			Condition:    metric.Condition,
+			Threshold:    metric.Value,
+			RequestName:  metric.RequestName,
+		})
+	}
+
+	return output
+}
+
+func flattenLoadTestTestEnvironmentVariables(input map[string]*string) map[string]string {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make(map[string]string, len(input))
+	for k, v := range input {
+		if v == nil {
+			continue
+		}
+		output[k] = *v
+	}
+	return output
+}
+
+// passFailMetricKeyIndex extracts the numeric suffix of a synthetic `metricN` key,
+// falling back to 0 (sorting it first) if the key doesn't match that shape.
+func passFailMetricKeyIndex(key string) int {
+	index, err := strconv.Atoi(strings.TrimPrefix(key, "metric"))
+	if err != nil {
+		return 0
+	}
+	return index
+}
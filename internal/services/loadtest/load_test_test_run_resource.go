@@ -0,0 +1,241 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2022-12-01/loadtests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtest/dataplane/testruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtest/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtest/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// LoadTestTestRunResource triggers an on-demand execution of an `azurerm_load_test_test`
+// and exposes its result. There is no `Update` - any change to its arguments forces a new
+// run, since a test run is an execution record rather than mutable configuration.
+type LoadTestTestRunResource struct{}
+
+var _ sdk.Resource = LoadTestTestRunResource{}
+
+type LoadTestTestRunResourceModel struct {
+	Name        string `tfschema:"name"`
+	TestId      string `tfschema:"test_id"`
+	DisplayName string `tfschema:"display_name"`
+	Description string `tfschema:"description"`
+
+	Status    string `tfschema:"status"`
+	PortalUrl string `tfschema:"portal_url"`
+}
+
+func (r LoadTestTestRunResource) ModelObject() interface{} {
+	return &LoadTestTestRunResourceModel{}
+}
+
+func (r LoadTestTestRunResource) ResourceType() string {
+	return "azurerm_load_test_test_run"
+}
+
+func (r LoadTestTestRunResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.TestRunID
+}
+
+func (r LoadTestTestRunResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"test_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.TestID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r LoadTestTestRunResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"portal_url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r LoadTestTestRunResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model LoadTestTestRunResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			testId, err := parse.TestID(model.TestId)
+			if err != nil {
+				return err
+			}
+
+			loadTestId := loadtests.NewLoadTestID(testId.SubscriptionId, testId.ResourceGroup, testId.LoadTestName)
+
+			dataPlaneURI, err := loadTestDataPlaneURI(ctx, metadata.Client.LoadTest.LoadTestsClient, loadTestId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewTestRunID(testId.SubscriptionId, testId.ResourceGroup, testId.LoadTestName, testId.TestId, model.Name)
+
+			testRunsClient := metadata.Client.LoadTest.NewTestRunsClient(dataPlaneURI)
+
+			_, existingHttpResponse, err := testRunsClient.Get(ctx, id.TestRunId)
+			if err != nil && !response.WasNotFound(existingHttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existingHttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			testRun := testruns.TestRun{
+				TestRunId:   id.TestRunId,
+				TestId:      testId.TestId,
+				DisplayName: model.DisplayName,
+				Description: model.Description,
+			}
+
+			if _, err := testRunsClient.CreateOrUpdateTestRun(ctx, id.TestRunId, testRun); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			// the run executes asynchronously - wait for it to reach a terminal status
+			// (rather than the `ACCEPTED`/`EXECUTING` status CreateOrUpdateTestRun returns
+			// synchronously) so that `status`/`portal_url` reflect the actual pass/fail
+			// outcome, which is the point of driving a test run through Terraform.
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending: []string{"ACCEPTED", "NOTSTARTED", "PROVISIONING", "PROVISIONED", "CONFIGURING", "CONFIGURED", "EXECUTING", "EXECUTED", "DEPROVISIONING", "DEPROVISIONED"},
+				Target:  []string{"DONE", "FAILED", "CANCELLED"},
+				Refresh: func() (interface{}, string, error) {
+					testRun, _, err := testRunsClient.Get(ctx, id.TestRunId)
+					if err != nil {
+						return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+					}
+					return testRun, testRun.Status, nil
+				},
+				MinTimeout: 30 * time.Second,
+				Timeout:    time.Until(deadline(ctx)),
+			}
+
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to complete: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+// deadline returns the point in time ctx will be cancelled - sdk.ResourceFunc derives
+// ctx's deadline from the Timeout declared above, so this lets StateChangeConf use up
+// the remainder of it rather than hard-coding a second, independent timeout.
+func deadline(ctx context.Context) time.Time {
+	if d, ok := ctx.Deadline(); ok {
+		return d
+	}
+	return time.Now().Add(1 * time.Hour)
+}
+
+func (r LoadTestTestRunResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.TestRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			loadTestId := loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroup, id.LoadTestName)
+			testId := parse.NewTestID(id.SubscriptionId, id.ResourceGroup, id.LoadTestName, id.TestId)
+
+			dataPlaneURI, err := loadTestDataPlaneURI(ctx, metadata.Client.LoadTest.LoadTestsClient, loadTestId)
+			if err != nil {
+				return err
+			}
+
+			testRunsClient := metadata.Client.LoadTest.NewTestRunsClient(dataPlaneURI)
+
+			testRun, httpResponse, err := testRunsClient.Get(ctx, id.TestRunId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if response.WasNotFound(httpResponse) {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := LoadTestTestRunResourceModel{
+				Name:        id.TestRunId,
+				TestId:      testId.ID(),
+				DisplayName: testRun.DisplayName,
+				Description: testRun.Description,
+				Status:      testRun.Status,
+				PortalUrl:   testRun.PortalUrl,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LoadTestTestRunResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.TestRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			loadTestId := loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroup, id.LoadTestName)
+
+			dataPlaneURI, err := loadTestDataPlaneURI(ctx, metadata.Client.LoadTest.LoadTestsClient, loadTestId)
+			if err != nil {
+				return err
+			}
+
+			testRunsClient := metadata.Client.LoadTest.NewTestRunsClient(dataPlaneURI)
+
+			if httpResponse, err := testRunsClient.Delete(ctx, id.TestRunId); err != nil && !response.WasNotFound(httpResponse) {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
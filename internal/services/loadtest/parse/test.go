@@ -0,0 +1,73 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type TestId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	LoadTestName   string
+	TestId         string
+}
+
+func NewTestID(subscriptionId, resourceGroup, loadTestName, testId string) TestId {
+	return TestId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		LoadTestName:   loadTestName,
+		TestId:         testId,
+	}
+}
+
+func (id TestId) String() string {
+	segments := []string{
+		fmt.Sprintf("Test %q", id.TestId),
+		fmt.Sprintf("Load Test Name %q", id.LoadTestName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Test", segmentsStr)
+}
+
+func (id TestId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.LoadTestService/loadTests/%s/tests/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadTestName, id.TestId)
+}
+
+// TestID parses a Test ID into an TestId struct
+func TestID(input string) (*TestId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := TestId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, resourceids.NewSubscriptionIDEmptyError(input)
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, resourceids.NewResourceGroupEmptyError(input)
+	}
+
+	if resourceId.LoadTestName, err = id.PopSegment("loadTests"); err != nil {
+		return nil, err
+	}
+	if resourceId.TestId, err = id.PopSegment("tests"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,79 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type TestRunId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	LoadTestName   string
+	TestId         string
+	TestRunId      string
+}
+
+func NewTestRunID(subscriptionId, resourceGroup, loadTestName, testId, testRunId string) TestRunId {
+	return TestRunId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		LoadTestName:   loadTestName,
+		TestId:         testId,
+		TestRunId:      testRunId,
+	}
+}
+
+func (id TestRunId) String() string {
+	segments := []string{
+		fmt.Sprintf("Test Run %q", id.TestRunId),
+		fmt.Sprintf("Test %q", id.TestId),
+		fmt.Sprintf("Load Test Name %q", id.LoadTestName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Test Run", segmentsStr)
+}
+
+func (id TestRunId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.LoadTestService/loadTests/%s/tests/%s/testRuns/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadTestName, id.TestId, id.TestRunId)
+}
+
+// TestRunID parses a TestRun ID into an TestRunId struct
+func TestRunID(input string) (*TestRunId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := TestRunId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, resourceids.NewSubscriptionIDEmptyError(input)
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, resourceids.NewResourceGroupEmptyError(input)
+	}
+
+	if resourceId.LoadTestName, err = id.PopSegment("loadTests"); err != nil {
+		return nil, err
+	}
+	if resourceId.TestId, err = id.PopSegment("tests"); err != nil {
+		return nil, err
+	}
+	if resourceId.TestRunId, err = id.PopSegment("testRuns"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -9,7 +9,7 @@ var _ sdk.TypedServiceRegistration = Registration{}
 type Registration struct{}
 
 func (r Registration) PackagePath() string {
-	return "TODO: Not implemented yet"
+	return "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtest"
 }
 
 func (r Registration) WebsiteCategories() []string {
@@ -23,11 +23,15 @@ func (r Registration) Name() string {
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		LoadTestDataSource{},
+	}
 }
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		LoadTestResource{},
+		LoadTestTestResource{},
+		LoadTestTestRunResource{},
 	}
 }
@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtest/parse"
+)
+
+func TestID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := parse.TestID(v); err != nil {
+		errors = append(errors, fmt.Errorf("can not parse %q as a Test ID: %v", key, err))
+	}
+
+	return
+}